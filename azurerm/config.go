@@ -0,0 +1,34 @@
+package azurerm
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// ArmClient holds the SDK clients used by this package's SQL Elastic Pool
+// resources and data sources.
+type ArmClient struct {
+	subscriptionId string
+
+	sqlDatabasesClient               sql.DatabasesClient
+	sqlElasticPoolsClient            sql.ElasticPoolsClient
+	sqlRecommendedElasticPoolsClient sql.RecommendedElasticPoolsClient
+}
+
+// registerSqlElasticPoolClients wires up the SQL clients this package
+// depends on against the given ARM endpoint/subscription/authorizer.
+func (c *ArmClient) registerSqlElasticPoolClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	c.subscriptionId = subscriptionId
+
+	databasesClient := sql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
+	databasesClient.Client.Authorizer = auth
+	c.sqlDatabasesClient = databasesClient
+
+	elasticPoolsClient := sql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
+	elasticPoolsClient.Client.Authorizer = auth
+	c.sqlElasticPoolsClient = elasticPoolsClient
+
+	recommendedElasticPoolsClient := sql.NewRecommendedElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
+	recommendedElasticPoolsClient.Client.Authorizer = auth
+	c.sqlRecommendedElasticPoolsClient = recommendedElasticPoolsClient
+}
@@ -0,0 +1,146 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmSqlRecommendedElasticPool() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSqlRecommendedElasticPoolRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"server_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"database_edition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dtu": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"database_dtu_min": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"database_dtu_max": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"storage_mb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"observation_period_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"observation_period_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"max_observed_dtu": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"max_observed_storage_mb": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"databases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceArmSqlRecommendedElasticPoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlRecommendedElasticPoolsClient
+
+	name := d.Get("name").(string)
+	serverName := d.Get("server_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(context.TODO(), resGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on Sql Recommended Elastic Pool %s: %s", name, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read SQL Recommended ElasticPool %q (resource group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	if props := resp.RecommendedElasticPoolProperties; props != nil {
+		d.Set("database_edition", string(props.DatabaseEdition))
+
+		if props.Dtu != nil {
+			d.Set("dtu", int(*props.Dtu))
+		}
+
+		if props.DatabaseDtuMin != nil {
+			d.Set("database_dtu_min", int(*props.DatabaseDtuMin))
+		}
+
+		if props.DatabaseDtuMax != nil {
+			d.Set("database_dtu_max", int(*props.DatabaseDtuMax))
+		}
+
+		if props.StorageMB != nil {
+			d.Set("storage_mb", int(*props.StorageMB))
+		}
+
+		if props.ObservationPeriodStart != nil {
+			d.Set("observation_period_start", props.ObservationPeriodStart.String())
+		}
+
+		if props.ObservationPeriodEnd != nil {
+			d.Set("observation_period_end", props.ObservationPeriodEnd.String())
+		}
+
+		if props.MaxObservedDtu != nil {
+			d.Set("max_observed_dtu", *props.MaxObservedDtu)
+		}
+
+		if props.MaxObservedStorageMB != nil {
+			d.Set("max_observed_storage_mb", *props.MaxObservedStorageMB)
+		}
+
+		databases := make([]string, 0)
+		if props.Databases != nil {
+			for _, id := range *props.Databases {
+				if id.ID != nil {
+					databases = append(databases, *id.ID)
+				}
+			}
+		}
+		d.Set("databases", databases)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMSqlRecommendedElasticPool_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_sql_recommended_elastic_pool.test"
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMSqlRecommendedElasticPool_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "database_edition"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "dtu"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "storage_mb"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMSqlRecommendedElasticPool_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                = "acctestsqldb%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  server_name         = azurerm_sql_server.test.name
+}
+
+data "azurerm_sql_recommended_elastic_pool" "test" {
+  name                = "pool1"
+  resource_group_name = azurerm_resource_group.test.name
+  server_name         = azurerm_sql_server.test.name
+
+  depends_on = [azurerm_sql_database.test]
+}
+`, rInt, location, rInt, rInt)
+}
@@ -0,0 +1,47 @@
+package azurerm
+
+import (
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Provider returns the subset of the AzureRM provider contributed by this
+// package: the SQL Elastic Pool family of resources and data sources.
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_SUBSCRIPTION_ID", ""),
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"azurerm_sql_elasticpool":                     resourceArmSqlElasticPool(),
+			"azurerm_sql_elasticpool_database_assignment": resourceArmSqlElasticPoolDatabaseAssignment(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"azurerm_sql_recommended_elastic_pool": dataSourceArmSqlRecommendedElasticPool(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	subscriptionId := d.Get("subscription_id").(string)
+
+	authorizer, err := auth.NewAuthorizerFromEnvironment()
+	if err != nil {
+		return nil, err
+	}
+
+	client := &ArmClient{}
+	client.registerSqlElasticPoolClients(azure.PublicCloud.ResourceManagerEndpoint, subscriptionId, authorizer)
+
+	return client, nil
+}
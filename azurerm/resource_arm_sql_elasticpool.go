@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/sql/mgmt/2015-05-01-preview/sql"
@@ -12,6 +15,26 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// sqlElasticPoolScheduleTagPrefix is the tag namespace used to persist
+// `scaling_schedule` blocks, since the Elastic Pool API itself has no concept
+// of a scaling schedule.
+const sqlElasticPoolScheduleTagPrefix = "terraform.sql-elasticpool.schedule."
+
+// sqlElasticPoolDtuEditionLimit describes the Azure-documented maxima for a
+// DTU-based edition, used by resourceArmSqlElasticPoolCustomizeDiff to catch
+// bad (edition, dtu, pool_size) combinations at plan time instead of a
+// cryptic 400 from ARM at apply time.
+type sqlElasticPoolDtuEditionLimit struct {
+	maxDtu       int
+	maxStorageGB int
+}
+
+var sqlElasticPoolDtuEditionLimits = map[string]sqlElasticPoolDtuEditionLimit{
+	string(sql.ElasticPoolEditionBasic):    {maxDtu: 1600, maxStorageGB: 156},
+	string(sql.ElasticPoolEditionStandard): {maxDtu: 3000, maxStorageGB: 1024},
+	string(sql.ElasticPoolEditionPremium):  {maxDtu: 4000, maxStorageGB: 4096},
+}
+
 func resourceArmSqlElasticPool() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmSqlElasticPoolCreate,
@@ -22,6 +45,8 @@ func resourceArmSqlElasticPool() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmSqlElasticPoolCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -69,6 +94,73 @@ func resourceArmSqlElasticPool() *schema.Resource {
 				Computed: true,
 			},
 
+			// NOTE: enabling this does not, by itself, make the pool scale on a
+			// timer. The active window is only reconciled against the pool when
+			// something runs `terraform apply` (see getArmSqlElasticPoolProperties)
+			// - `terraform plan`/`refresh` never mutate the pool. To actually scale
+			// on schedule, something (e.g. a cron job) needs to apply this
+			// resource at/around each window's start and end time.
+			"scaling_schedule_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"scaling_schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"cron": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"days_of_week": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"start_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"end_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "UTC",
+						},
+
+						"dtu": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"db_dtu_min": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"db_dtu_max": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+
 			"creation_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -90,11 +182,14 @@ func resourceArmSqlElasticPoolCreate(d *schema.ResourceData, meta interface{}) e
 	resGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
+	schedules := expandSqlElasticPoolScalingSchedules(d)
+	addSqlElasticPoolScalingScheduleTags(tags, schedules)
+
 	elasticPool := sql.ElasticPool{
 		Name:                  &name,
 		Location:              &location,
 		ElasticPoolProperties: getArmSqlElasticPoolProperties(d),
-		Tags: expandTags(tags),
+		Tags:                  expandTags(tags),
 	}
 
 	future, err := client.CreateOrUpdate(context.TODO(), resGroup, serverName, name, elasticPool)
@@ -144,17 +239,38 @@ func resourceArmSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) err
 
 	if elasticPool := resp.ElasticPoolProperties; elasticPool != nil {
 		d.Set("edition", string(elasticPool.Edition))
-		d.Set("dtu", int(*elasticPool.Dtu))
-		d.Set("db_dtu_min", int(*elasticPool.DatabaseDtuMin))
-		d.Set("db_dtu_max", int(*elasticPool.DatabaseDtuMax))
-		d.Set("pool_size", int(*elasticPool.StorageMB))
+
+		if elasticPool.Dtu != nil {
+			d.Set("dtu", int(*elasticPool.Dtu))
+		}
+
+		if elasticPool.DatabaseDtuMin != nil {
+			d.Set("db_dtu_min", int(*elasticPool.DatabaseDtuMin))
+		}
+
+		if elasticPool.DatabaseDtuMax != nil {
+			d.Set("db_dtu_max", int(*elasticPool.DatabaseDtuMax))
+		}
+
+		if elasticPool.StorageMB != nil {
+			d.Set("pool_size", int(*elasticPool.StorageMB))
+		}
 
 		if date := elasticPool.CreationDate; date != nil {
 			d.Set("creation_date", date.Format(time.RFC3339))
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	// NOTE: reconciling the active scaling window against `resp` happens at
+	// apply time only (see getArmSqlElasticPoolProperties, invoked from
+	// Create/Update), never here - Read backs `terraform plan`/`refresh` too,
+	// and those must stay side-effect-free rather than issuing a live PATCH.
+	schedules := flattenSqlElasticPoolScalingSchedulesFromTags(resp.Tags)
+	if err := d.Set("scaling_schedule", sqlElasticPoolScalingSchedulesToResourceData(schedules)); err != nil {
+		return fmt.Errorf("Error setting `scaling_schedule`: %+v", err)
+	}
+
+	flattenAndSetTags(d, filterSqlElasticPoolScheduleTags(resp.Tags))
 
 	return nil
 }
@@ -197,6 +313,27 @@ func getArmSqlElasticPoolProperties(d *schema.ResourceData) *sql.ElasticPoolProp
 		props.StorageMB = &poolSize
 	}
 
+	// This is the only point where the active scaling window is reconciled
+	// against the pool - it only runs from Create/Update (an explicit apply),
+	// never from Read, which must stay side-effect-free for plan/refresh.
+	if d.Get("scaling_schedule_enabled").(bool) {
+		schedules := expandSqlElasticPoolScalingSchedules(d)
+		if active := activeSqlElasticPoolScalingSchedule(schedules, time.Now()); active != nil {
+			activeDtu := int32(active.Dtu)
+			props.Dtu = &activeDtu
+
+			if active.DbDtuMin > 0 {
+				activeDbDtuMin := int32(active.DbDtuMin)
+				props.DatabaseDtuMin = &activeDbDtuMin
+			}
+
+			if active.DbDtuMax > 0 {
+				activeDbDtuMax := int32(active.DbDtuMax)
+				props.DatabaseDtuMax = &activeDbDtuMax
+			}
+		}
+	}
+
 	return props
 }
 
@@ -209,6 +346,328 @@ func parseArmSqlElasticPoolId(sqlElasticPoolId string) (string, string, string,
 	return id.ResourceGroup, id.Path["servers"], id.Path["elasticPools"], nil
 }
 
+// resourceArmSqlElasticPoolCustomizeDiff marks `dtu`/`db_dtu_min`/`db_dtu_max`
+// as computed while a scaling schedule is active, since their values are then
+// driven by the active window rather than the user's configuration - without
+// this Terraform would perpetually plan a diff back to the configured value.
+func resourceArmSqlElasticPoolCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("scaling_schedule_enabled").(bool) && len(d.Get("scaling_schedule").([]interface{})) > 0 {
+		for _, field := range []string{"dtu", "db_dtu_min", "db_dtu_max"} {
+			if err := d.SetNewComputed(field); err != nil {
+				return fmt.Errorf("Error marking `%s` as computed: %+v", field, err)
+			}
+		}
+	}
+
+	if err := validateSqlElasticPoolScalingSchedules(d); err != nil {
+		return err
+	}
+
+	return validateSqlElasticPoolDtuEditionMatrix(d)
+}
+
+// validateSqlElasticPoolScalingSchedules rejects a `cron`-only
+// `scaling_schedule` block. `cron` is offered as an alternative to
+// `days_of_week`/`start_time`/`end_time`, but activeSqlElasticPoolScalingSchedule
+// doesn't evaluate it - accepting one here and silently never activating it
+// would be worse than failing the plan outright.
+func validateSqlElasticPoolScalingSchedules(d *schema.ResourceDiff) error {
+	schedules := d.Get("scaling_schedule").([]interface{})
+
+	for _, v := range schedules {
+		schedule := v.(map[string]interface{})
+
+		if schedule["cron"].(string) == "" {
+			continue
+		}
+
+		if schedule["start_time"].(string) == "" || schedule["end_time"].(string) == "" {
+			return fmt.Errorf("`scaling_schedule` %q: `cron` is not currently evaluated - set `days_of_week`/`start_time`/`end_time` instead", schedule["name"].(string))
+		}
+	}
+
+	return nil
+}
+
+// validateSqlElasticPoolDtuEditionMatrix checks `dtu`, `pool_size`,
+// `db_dtu_min` and `db_dtu_max` against the edition's maxima in
+// sqlElasticPoolDtuEditionLimits, and that `db_dtu_min` does not exceed
+// `db_dtu_max`, so these fail at `terraform plan` instead of surfacing as a
+// 400 from ARM during apply. Values below those maxima are not checked
+// against the edition's discrete set of allowed eDTU sizes.
+func validateSqlElasticPoolDtuEditionMatrix(d *schema.ResourceDiff) error {
+	edition := d.Get("edition").(string)
+	dtu := d.Get("dtu").(int)
+
+	var poolSize, dbDtuMin, dbDtuMax *int
+	if v, ok := d.GetOk("pool_size"); ok {
+		i := v.(int)
+		poolSize = &i
+	}
+	if v, ok := d.GetOk("db_dtu_min"); ok {
+		i := v.(int)
+		dbDtuMin = &i
+	}
+	if v, ok := d.GetOk("db_dtu_max"); ok {
+		i := v.(int)
+		dbDtuMax = &i
+	}
+
+	return validateSqlElasticPoolDtuLimits(edition, dtu, poolSize, dbDtuMin, dbDtuMax)
+}
+
+// validateSqlElasticPoolDtuLimits is the pure validation logic behind
+// validateSqlElasticPoolDtuEditionMatrix, split out so it can be unit tested
+// without constructing a *schema.ResourceDiff.
+func validateSqlElasticPoolDtuLimits(edition string, dtu int, poolSize, dbDtuMin, dbDtuMax *int) error {
+	limit, ok := sqlElasticPoolDtuEditionLimits[edition]
+	if !ok {
+		return nil
+	}
+
+	if dtu > limit.maxDtu {
+		return fmt.Errorf("`dtu` cannot exceed %d for a %q pool, got %d", limit.maxDtu, edition, dtu)
+	}
+
+	if poolSize != nil {
+		maxStorageMB := limit.maxStorageGB * 1024
+		if *poolSize > maxStorageMB {
+			return fmt.Errorf("`pool_size` cannot exceed %d MB (%d GB) for a %q pool, got %d", maxStorageMB, limit.maxStorageGB, edition, *poolSize)
+		}
+	}
+
+	if dbDtuMin != nil && *dbDtuMin > limit.maxDtu {
+		return fmt.Errorf("`db_dtu_min` cannot exceed %d for a %q pool, got %d", limit.maxDtu, edition, *dbDtuMin)
+	}
+
+	if dbDtuMax != nil && *dbDtuMax > limit.maxDtu {
+		return fmt.Errorf("`db_dtu_max` cannot exceed %d for a %q pool, got %d", limit.maxDtu, edition, *dbDtuMax)
+	}
+
+	if dbDtuMin != nil && dbDtuMax != nil && *dbDtuMin > *dbDtuMax {
+		return fmt.Errorf("`db_dtu_min` (%d) cannot be greater than `db_dtu_max` (%d)", *dbDtuMin, *dbDtuMax)
+	}
+
+	return nil
+}
+
+// sqlElasticPoolScalingSchedule is the decoded form of a `scaling_schedule`
+// block. It is persisted to the pool as a tag, since the underlying API has
+// no native concept of a time-window based schedule.
+type sqlElasticPoolScalingSchedule struct {
+	Name       string
+	Cron       string
+	DaysOfWeek []string
+	StartTime  string
+	EndTime    string
+	Timezone   string
+	Dtu        int
+	DbDtuMin   int
+	DbDtuMax   int
+}
+
+func expandSqlElasticPoolScalingSchedules(d *schema.ResourceData) []sqlElasticPoolScalingSchedule {
+	raw := d.Get("scaling_schedule").([]interface{})
+	schedules := make([]sqlElasticPoolScalingSchedule, 0, len(raw))
+
+	for _, v := range raw {
+		item := v.(map[string]interface{})
+
+		daysOfWeek := make([]string, 0)
+		for _, day := range item["days_of_week"].([]interface{}) {
+			daysOfWeek = append(daysOfWeek, day.(string))
+		}
+
+		schedules = append(schedules, sqlElasticPoolScalingSchedule{
+			Name:       item["name"].(string),
+			Cron:       item["cron"].(string),
+			DaysOfWeek: daysOfWeek,
+			StartTime:  item["start_time"].(string),
+			EndTime:    item["end_time"].(string),
+			Timezone:   item["timezone"].(string),
+			Dtu:        item["dtu"].(int),
+			DbDtuMin:   item["db_dtu_min"].(int),
+			DbDtuMax:   item["db_dtu_max"].(int),
+		})
+	}
+
+	return schedules
+}
+
+// addSqlElasticPoolScalingScheduleTags encodes each schedule into the
+// `terraform.sql-elasticpool.schedule.<name>` tag namespace, merging them
+// into the tags that are about to be sent to the API.
+func addSqlElasticPoolScalingScheduleTags(tags map[string]interface{}, schedules []sqlElasticPoolScalingSchedule) {
+	for _, schedule := range schedules {
+		tags[sqlElasticPoolScheduleTagPrefix+schedule.Name] = encodeSqlElasticPoolScalingSchedule(schedule)
+	}
+}
+
+func encodeSqlElasticPoolScalingSchedule(schedule sqlElasticPoolScalingSchedule) string {
+	values := url.Values{}
+	values.Set("cron", schedule.Cron)
+	values.Set("days_of_week", strings.Join(schedule.DaysOfWeek, ","))
+	values.Set("start_time", schedule.StartTime)
+	values.Set("end_time", schedule.EndTime)
+	values.Set("timezone", schedule.Timezone)
+	values.Set("dtu", strconv.Itoa(schedule.Dtu))
+	values.Set("db_dtu_min", strconv.Itoa(schedule.DbDtuMin))
+	values.Set("db_dtu_max", strconv.Itoa(schedule.DbDtuMax))
+
+	return values.Encode()
+}
+
+func decodeSqlElasticPoolScalingSchedule(name string, raw string) (*sqlElasticPoolScalingSchedule, error) {
+	values, err := url.ParseQuery(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	dtu, _ := strconv.Atoi(values.Get("dtu"))
+	dbDtuMin, _ := strconv.Atoi(values.Get("db_dtu_min"))
+	dbDtuMax, _ := strconv.Atoi(values.Get("db_dtu_max"))
+
+	var daysOfWeek []string
+	if v := values.Get("days_of_week"); v != "" {
+		daysOfWeek = strings.Split(v, ",")
+	}
+
+	return &sqlElasticPoolScalingSchedule{
+		Name:       name,
+		Cron:       values.Get("cron"),
+		DaysOfWeek: daysOfWeek,
+		StartTime:  values.Get("start_time"),
+		EndTime:    values.Get("end_time"),
+		Timezone:   values.Get("timezone"),
+		Dtu:        dtu,
+		DbDtuMin:   dbDtuMin,
+		DbDtuMax:   dbDtuMax,
+	}, nil
+}
+
+// filterSqlElasticPoolScheduleTags strips the internal schedule tags out of
+// a tag set before it's surfaced on the `tags` attribute.
+func filterSqlElasticPoolScheduleTags(tags map[string]*string) map[string]*string {
+	result := make(map[string]*string)
+	for k, v := range tags {
+		if strings.HasPrefix(k, sqlElasticPoolScheduleTagPrefix) {
+			continue
+		}
+		result[k] = v
+	}
+
+	return result
+}
+
+func flattenSqlElasticPoolScalingSchedulesFromTags(tags map[string]*string) []sqlElasticPoolScalingSchedule {
+	schedules := make([]sqlElasticPoolScalingSchedule, 0)
+
+	for key, value := range tags {
+		if !strings.HasPrefix(key, sqlElasticPoolScheduleTagPrefix) || value == nil {
+			continue
+		}
+
+		name := strings.TrimPrefix(key, sqlElasticPoolScheduleTagPrefix)
+		schedule, err := decodeSqlElasticPoolScalingSchedule(name, *value)
+		if err != nil {
+			log.Printf("[WARN] Unable to decode scaling_schedule tag %q: %+v", key, err)
+			continue
+		}
+
+		schedules = append(schedules, *schedule)
+	}
+
+	return schedules
+}
+
+func sqlElasticPoolScalingSchedulesToResourceData(schedules []sqlElasticPoolScalingSchedule) []interface{} {
+	result := make([]interface{}, 0, len(schedules))
+
+	for _, schedule := range schedules {
+		daysOfWeek := make([]interface{}, len(schedule.DaysOfWeek))
+		for i, day := range schedule.DaysOfWeek {
+			daysOfWeek[i] = day
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":         schedule.Name,
+			"cron":         schedule.Cron,
+			"days_of_week": daysOfWeek,
+			"start_time":   schedule.StartTime,
+			"end_time":     schedule.EndTime,
+			"timezone":     schedule.Timezone,
+			"dtu":          schedule.Dtu,
+			"db_dtu_min":   schedule.DbDtuMin,
+			"db_dtu_max":   schedule.DbDtuMax,
+		})
+	}
+
+	return result
+}
+
+// activeSqlElasticPoolScalingSchedule returns the schedule whose window
+// contains `now`, evaluated in each schedule's own timezone. Schedules without
+// both `start_time` and `end_time` are skipped, and `cron` is never evaluated,
+// since this provider doesn't vendor a cron parser (a `cron`-only block is
+// rejected at plan time by validateSqlElasticPoolScalingSchedules).
+func activeSqlElasticPoolScalingSchedule(schedules []sqlElasticPoolScalingSchedule, now time.Time) *sqlElasticPoolScalingSchedule {
+	for _, schedule := range schedules {
+		if schedule.StartTime == "" || schedule.EndTime == "" {
+			continue
+		}
+
+		loc, err := time.LoadLocation(schedule.Timezone)
+		if err != nil {
+			loc = time.UTC
+		}
+		localNow := now.In(loc)
+
+		if len(schedule.DaysOfWeek) > 0 && !sqlElasticPoolScheduleMatchesDay(schedule.DaysOfWeek, localNow.Weekday()) {
+			continue
+		}
+
+		if sqlElasticPoolScheduleMatchesWindow(schedule.StartTime, schedule.EndTime, localNow) {
+			active := schedule
+			return &active
+		}
+	}
+
+	return nil
+}
+
+func sqlElasticPoolScheduleMatchesDay(daysOfWeek []string, day time.Weekday) bool {
+	for _, d := range daysOfWeek {
+		if strings.EqualFold(d, day.String()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sqlElasticPoolScheduleMatchesWindow(startTime string, endTime string, now time.Time) bool {
+	start, err := time.Parse("15:04", startTime)
+	if err != nil {
+		return false
+	}
+
+	end, err := time.Parse("15:04", endTime)
+	if err != nil {
+		return false
+	}
+
+	nowMinutes := now.Hour()*60 + now.Minute()
+	startMinutes := start.Hour()*60 + start.Minute()
+	endMinutes := end.Hour()*60 + end.Minute()
+
+	if startMinutes <= endMinutes {
+		return nowMinutes >= startMinutes && nowMinutes < endMinutes
+	}
+
+	// the window wraps past midnight, e.g. 22:00 -> 06:00
+	return nowMinutes >= startMinutes || nowMinutes < endMinutes
+}
+
 func validateSqlElasticPoolEdition() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		string(sql.ElasticPoolEditionBasic),
@@ -0,0 +1,173 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlElasticPoolDatabaseAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlElasticPoolDatabaseAssignmentCreateUpdate,
+		Read:   resourceArmSqlElasticPoolDatabaseAssignmentRead,
+		Update: resourceArmSqlElasticPoolDatabaseAssignmentCreateUpdate,
+		Delete: resourceArmSqlElasticPoolDatabaseAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"elastic_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// used to restore the database to a standalone database when this
+			// resource is deleted, since Azure has no concept of "no pool" to
+			// revert to.
+			"removal_edition": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(sql.Standard),
+			},
+
+			"removal_service_objective_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "S0",
+			},
+		},
+	}
+}
+
+func resourceArmSqlElasticPoolDatabaseAssignmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlDatabasesClient
+
+	log.Printf("[INFO] preparing arguments for SQL ElasticPool Database Assignment creation.")
+
+	databaseID := d.Get("database_id").(string)
+	elasticPoolID := d.Get("elastic_pool_id").(string)
+
+	resGroup, serverName, databaseName, err := parseArmSqlDatabaseId(databaseID)
+	if err != nil {
+		return err
+	}
+
+	_, _, elasticPoolName, err := parseArmSqlElasticPoolId(elasticPoolID)
+	if err != nil {
+		return err
+	}
+
+	// ARM's `CreateOrUpdate` is a full-resource PUT - read the database back
+	// first and mutate only the fields this resource owns, so properties the
+	// caller doesn't manage (collation, max size, zone redundancy, etc.)
+	// aren't reset to their zero value on every apply.
+	database, err := client.Get(context.TODO(), resGroup, serverName, databaseName, "")
+	if err != nil {
+		return fmt.Errorf("Error reading Sql Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resGroup, err)
+	}
+
+	if database.DatabaseProperties == nil {
+		database.DatabaseProperties = &sql.DatabaseProperties{}
+	}
+
+	database.DatabaseProperties.ElasticPoolName = &elasticPoolName
+	database.DatabaseProperties.RequestedServiceObjectiveName = nil
+
+	future, err := client.CreateOrUpdate(context.TODO(), resGroup, serverName, databaseName, database)
+	if err != nil {
+		return fmt.Errorf("Error assigning Sql Database %q (Server %q / Resource Group %q) to Elastic Pool %q: %+v", databaseName, serverName, resGroup, elasticPoolName, err)
+	}
+
+	if err := future.WaitForCompletion(context.TODO(), client.Client); err != nil {
+		return fmt.Errorf("Error waiting for assignment of Sql Database %q (Server %q / Resource Group %q) to Elastic Pool %q: %+v", databaseName, serverName, resGroup, elasticPoolName, err)
+	}
+
+	d.SetId(databaseID)
+
+	return resourceArmSqlElasticPoolDatabaseAssignmentRead(d, meta)
+}
+
+func resourceArmSqlElasticPoolDatabaseAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.sqlDatabasesClient
+
+	resGroup, serverName, databaseName, err := parseArmSqlDatabaseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(context.TODO(), resGroup, serverName, databaseName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Sql Database %s: %s", databaseName, err)
+	}
+
+	d.Set("database_id", resp.ID)
+
+	if props := resp.DatabaseProperties; props != nil {
+		if props.ElasticPoolName == nil || *props.ElasticPoolName == "" {
+			// the database is no longer assigned to a pool, so this assignment is gone
+			d.SetId("")
+			return nil
+		}
+
+		elasticPoolID := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/elasticPools/%s",
+			armClient.subscriptionId, resGroup, serverName, *props.ElasticPoolName)
+		d.Set("elastic_pool_id", elasticPoolID)
+	}
+
+	return nil
+}
+
+func resourceArmSqlElasticPoolDatabaseAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlDatabasesClient
+
+	resGroup, serverName, databaseName, err := parseArmSqlDatabaseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	removalEdition := d.Get("removal_edition").(string)
+	removalServiceObjectiveName := d.Get("removal_service_objective_name").(string)
+
+	// as in Create/Update, read the database back and mutate only the fields
+	// this resource owns rather than PUTting a mostly-zero-valued payload.
+	database, err := client.Get(context.TODO(), resGroup, serverName, databaseName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(database.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error reading Sql Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resGroup, err)
+	}
+
+	if database.DatabaseProperties == nil {
+		database.DatabaseProperties = &sql.DatabaseProperties{}
+	}
+
+	emptyElasticPoolName := ""
+
+	database.DatabaseProperties.Edition = sql.DatabaseEdition(removalEdition)
+	database.DatabaseProperties.ElasticPoolName = &emptyElasticPoolName
+	database.DatabaseProperties.RequestedServiceObjectiveName = &removalServiceObjectiveName
+
+	future, err := client.CreateOrUpdate(context.TODO(), resGroup, serverName, databaseName, database)
+	if err != nil {
+		return fmt.Errorf("Error removing Sql Database %q (Server %q / Resource Group %q) from its Elastic Pool: %+v", databaseName, serverName, resGroup, err)
+	}
+
+	return future.WaitForCompletion(context.TODO(), client.Client)
+}
@@ -0,0 +1,131 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMSqlElasticPoolDatabaseAssignment_basic(t *testing.T) {
+	resourceName := "azurerm_sql_elasticpool_database_assignment.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSqlElasticPoolDatabaseAssignment_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlElasticPoolDatabaseAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlElasticPoolDatabaseAssignmentExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlElasticPoolDatabaseAssignmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resGroup, serverName, databaseName, err := parseArmSqlDatabaseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).sqlDatabasesClient
+		resp, err := client.Get(context.TODO(), resGroup, serverName, databaseName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on sqlDatabasesClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Sql Database %q (Resource Group %q / Server %q) does not exist", databaseName, resGroup, serverName)
+		}
+
+		if resp.DatabaseProperties == nil || resp.DatabaseProperties.ElasticPoolName == nil || *resp.DatabaseProperties.ElasticPoolName == "" {
+			return fmt.Errorf("Bad: Sql Database %q is not assigned to an Elastic Pool", databaseName)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlElasticPoolDatabaseAssignmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).sqlDatabasesClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_sql_elasticpool_database_assignment" {
+			continue
+		}
+
+		resGroup, serverName, databaseName, err := parseArmSqlDatabaseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(context.TODO(), resGroup, serverName, databaseName, "")
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return err
+		}
+
+		if resp.DatabaseProperties != nil && resp.DatabaseProperties.ElasticPoolName != nil && *resp.DatabaseProperties.ElasticPoolName != "" {
+			return fmt.Errorf("Sql Database %q is still assigned to an Elastic Pool", databaseName)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMSqlElasticPoolDatabaseAssignment_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_elasticpool" "test" {
+  name                = "acctestep%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  server_name         = azurerm_sql_server.test.name
+  edition             = "Standard"
+  dtu                 = 50
+  pool_size           = 5000
+}
+
+resource "azurerm_sql_database" "test" {
+  name                = "acctestsqldb%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  server_name         = azurerm_sql_server.test.name
+}
+
+resource "azurerm_sql_elasticpool_database_assignment" "test" {
+  database_id     = azurerm_sql_database.test.id
+  elastic_pool_id = azurerm_sql_elasticpool.test.id
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -0,0 +1,179 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEncodeDecodeSqlElasticPoolScalingSchedule(t *testing.T) {
+	schedule := sqlElasticPoolScalingSchedule{
+		Name:       "business-hours",
+		Cron:       "",
+		DaysOfWeek: []string{"Monday", "Tuesday"},
+		StartTime:  "08:00",
+		EndTime:    "18:00",
+		Timezone:   "America/New_York",
+		Dtu:        100,
+		DbDtuMin:   10,
+		DbDtuMax:   50,
+	}
+
+	encoded := encodeSqlElasticPoolScalingSchedule(schedule)
+
+	decoded, err := decodeSqlElasticPoolScalingSchedule(schedule.Name, encoded)
+	if err != nil {
+		t.Fatalf("decodeSqlElasticPoolScalingSchedule returned an error: %+v", err)
+	}
+
+	if !reflect.DeepEqual(*decoded, schedule) {
+		t.Fatalf("round-tripped schedule does not match original.\noriginal: %+v\ndecoded:  %+v", schedule, *decoded)
+	}
+}
+
+func TestDecodeSqlElasticPoolScalingSchedule_emptyDaysOfWeek(t *testing.T) {
+	schedule := sqlElasticPoolScalingSchedule{
+		Name:      "always-on",
+		StartTime: "00:00",
+		EndTime:   "23:59",
+		Timezone:  "UTC",
+		Dtu:       50,
+	}
+
+	encoded := encodeSqlElasticPoolScalingSchedule(schedule)
+
+	decoded, err := decodeSqlElasticPoolScalingSchedule(schedule.Name, encoded)
+	if err != nil {
+		t.Fatalf("decodeSqlElasticPoolScalingSchedule returned an error: %+v", err)
+	}
+
+	if len(decoded.DaysOfWeek) != 0 {
+		t.Fatalf("expected no days_of_week, got %+v", decoded.DaysOfWeek)
+	}
+}
+
+func TestActiveSqlElasticPoolScalingSchedule(t *testing.T) {
+	cases := []struct {
+		name      string
+		schedules []sqlElasticPoolScalingSchedule
+		now       time.Time
+		wantName  string
+	}{
+		{
+			name: "within a same-day window",
+			schedules: []sqlElasticPoolScalingSchedule{
+				{Name: "business-hours", DaysOfWeek: []string{"Monday"}, StartTime: "08:00", EndTime: "18:00", Timezone: "UTC", Dtu: 100},
+			},
+			now:      time.Date(2026, 7, 27, 12, 0, 0, 0, time.UTC),
+			wantName: "business-hours",
+		},
+		{
+			name: "outside a same-day window",
+			schedules: []sqlElasticPoolScalingSchedule{
+				{Name: "business-hours", DaysOfWeek: []string{"Monday"}, StartTime: "08:00", EndTime: "18:00", Timezone: "UTC", Dtu: 100},
+			},
+			now:      time.Date(2026, 7, 27, 20, 0, 0, 0, time.UTC),
+			wantName: "",
+		},
+		{
+			name: "wrong day of week",
+			schedules: []sqlElasticPoolScalingSchedule{
+				{Name: "business-hours", DaysOfWeek: []string{"Tuesday"}, StartTime: "08:00", EndTime: "18:00", Timezone: "UTC", Dtu: 100},
+			},
+			now:      time.Date(2026, 7, 27, 12, 0, 0, 0, time.UTC), // a Monday
+			wantName: "",
+		},
+		{
+			name: "window wraps past midnight, now is before midnight",
+			schedules: []sqlElasticPoolScalingSchedule{
+				{Name: "overnight", StartTime: "22:00", EndTime: "06:00", Timezone: "UTC", Dtu: 25},
+			},
+			now:      time.Date(2026, 7, 27, 23, 0, 0, 0, time.UTC),
+			wantName: "overnight",
+		},
+		{
+			name: "window wraps past midnight, now is after midnight",
+			schedules: []sqlElasticPoolScalingSchedule{
+				{Name: "overnight", StartTime: "22:00", EndTime: "06:00", Timezone: "UTC", Dtu: 25},
+			},
+			now:      time.Date(2026, 7, 27, 2, 0, 0, 0, time.UTC),
+			wantName: "overnight",
+		},
+		{
+			name: "window wraps past midnight, now is outside the window",
+			schedules: []sqlElasticPoolScalingSchedule{
+				{Name: "overnight", StartTime: "22:00", EndTime: "06:00", Timezone: "UTC", Dtu: 25},
+			},
+			now:      time.Date(2026, 7, 27, 12, 0, 0, 0, time.UTC),
+			wantName: "",
+		},
+		{
+			name: "evaluated in the schedule's own timezone",
+			schedules: []sqlElasticPoolScalingSchedule{
+				// 09:00 in New York is 13:00/14:00 UTC depending on DST - pick a
+				// `now` that's only inside the window once converted.
+				{Name: "ny-morning", StartTime: "09:00", EndTime: "10:00", Timezone: "America/New_York", Dtu: 50},
+			},
+			now:      time.Date(2026, 7, 27, 13, 30, 0, 0, time.UTC),
+			wantName: "ny-morning",
+		},
+		{
+			name: "cron-only schedule is never active",
+			schedules: []sqlElasticPoolScalingSchedule{
+				{Name: "cron-only", Cron: "0 8 * * 1", Dtu: 100},
+			},
+			now:      time.Date(2026, 7, 27, 8, 0, 0, 0, time.UTC),
+			wantName: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			active := activeSqlElasticPoolScalingSchedule(tc.schedules, tc.now)
+
+			switch {
+			case tc.wantName == "" && active != nil:
+				t.Fatalf("expected no active schedule, got %q", active.Name)
+			case tc.wantName != "" && active == nil:
+				t.Fatalf("expected active schedule %q, got none", tc.wantName)
+			case tc.wantName != "" && active.Name != tc.wantName:
+				t.Fatalf("expected active schedule %q, got %q", tc.wantName, active.Name)
+			}
+		})
+	}
+}
+
+func TestValidateSqlElasticPoolDtuLimits(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+
+	cases := []struct {
+		name      string
+		edition   string
+		dtu       int
+		poolSize  *int
+		dbDtuMin  *int
+		dbDtuMax  *int
+		wantError bool
+	}{
+		{name: "valid Standard pool", edition: "Standard", dtu: 100, poolSize: intPtr(100 * 1024), wantError: false},
+		{name: "dtu exceeds Standard maximum", edition: "Standard", dtu: 5000, wantError: true},
+		{name: "pool_size exceeds Standard maximum", edition: "Standard", dtu: 100, poolSize: intPtr(2 * 1024 * 1024), wantError: true},
+		{name: "db_dtu_min exceeds edition maximum", edition: "Basic", dtu: 100, dbDtuMin: intPtr(2000), wantError: true},
+		{name: "db_dtu_max exceeds edition maximum", edition: "Basic", dtu: 100, dbDtuMax: intPtr(2000), wantError: true},
+		{name: "db_dtu_min greater than db_dtu_max", edition: "Standard", dtu: 100, dbDtuMin: intPtr(50), dbDtuMax: intPtr(10), wantError: true},
+		{name: "unrecognized edition is not validated here", edition: "GeneralPurpose", dtu: 999999, wantError: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateSqlElasticPoolDtuLimits(tc.edition, tc.dtu, tc.poolSize, tc.dbDtuMin, tc.dbDtuMax)
+
+			if tc.wantError && err == nil {
+				t.Fatalf("expected an error, got none")
+			}
+			if !tc.wantError && err != nil {
+				t.Fatalf("expected no error, got %+v", err)
+			}
+		})
+	}
+}